@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/cors"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joho/godotenv"
+
+	"github.com/DreadHalfling9/REST-API-poc/internal/authn"
+	"github.com/DreadHalfling9/REST-API-poc/internal/handlers"
+	"github.com/DreadHalfling9/REST-API-poc/internal/httpmw"
+	"github.com/DreadHalfling9/REST-API-poc/internal/migrations"
+	"github.com/DreadHalfling9/REST-API-poc/internal/repository"
+	"github.com/DreadHalfling9/REST-API-poc/internal/service"
+)
+
+const shutdownTimeout = 10 * time.Second
+
+func connectPostgres(databaseURL string) *pgxpool.Pool {
+	pool, err := pgxpool.New(context.Background(), databaseURL)
+	if err != nil {
+		log.Fatalf("Unable to connect to database: %v", err)
+	}
+	return pool
+}
+
+func jwtSecret() []byte {
+	secret := os.Getenv("JWT_SECRET")
+	if secret == "" {
+		log.Fatal("JWT_SECRET not set")
+	}
+	return []byte(secret)
+}
+
+func newRouter(logger *slog.Logger, authService *service.AuthService, authHandler *handlers.AuthHandler, todoHandler *handlers.TodoHandler, healthHandler *handlers.HealthHandler) http.Handler {
+	r := chi.NewRouter()
+
+	r.Use(middleware.RequestID)
+	r.Use(httpmw.RequestLogger(logger))
+	r.Use(middleware.Recoverer)
+	r.Use(middleware.Timeout(30 * time.Second))
+	r.Use(cors.Handler(cors.Options{
+		AllowedOrigins: []string{"*"},
+		AllowedMethods: []string{"GET", "POST", "PUT", "PATCH", "DELETE"},
+		AllowedHeaders: []string{"Content-Type", "Authorization"},
+	}))
+	r.Use(middleware.SetHeader("Content-Type", "application/json"))
+
+	r.Get("/healthz", healthHandler.Healthz)
+	r.Get("/readyz", healthHandler.Readyz)
+
+	r.Route("/v1", func(r chi.Router) {
+		r.Post("/signup", authHandler.Signup)
+		r.Post("/login", authHandler.Login)
+
+		r.Group(func(r chi.Router) {
+			r.Use(authn.Middleware(authService))
+			r.Post("/logout", authHandler.Logout)
+			r.Route("/todos", todoHandler.Routes)
+		})
+	})
+
+	return r
+}
+
+func main() {
+	// .env is a convenience for local development; containers are normally
+	// given env vars directly, so a missing file is not an error.
+	if err := godotenv.Load(); err != nil && !os.IsNotExist(err) {
+		log.Fatalf("Error loading .env file: %v", err)
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrate(os.Args[2:])
+		return
+	}
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+
+	databaseURL := os.Getenv("DATABASE_URL")
+	if databaseURL == "" {
+		log.Fatal("DATABASE_URL not set")
+	}
+	backend, err := repository.Backend(databaseURL)
+	if err != nil {
+		log.Fatalf("Invalid DATABASE_URL: %v", err)
+	}
+
+	// Postgres is the only backend with migrations and persistent user/session
+	// storage; sqlite and memory only swap out todo storage for lightweight or
+	// dependency-free runs, so they skip migrations and keep accounts and
+	// revoked tokens in process memory.
+	var pgPool *pgxpool.Pool
+	var userRepo repository.UserRepository
+	var revokedTokenRepo repository.RevokedTokenRepository
+	ready := func(context.Context) error { return nil }
+
+	if backend == "postgres" {
+		pgPool = connectPostgres(databaseURL)
+		defer pgPool.Close()
+
+		if err := migrations.Up(context.Background(), pgPool); err != nil {
+			log.Fatalf("Failed to apply migrations: %v", err)
+		}
+
+		userRepo = repository.NewPostgresUserRepository(pgPool)
+		revokedTokenRepo = repository.NewPostgresRevokedTokenRepository(pgPool)
+		ready = pgPool.Ping
+	} else {
+		userRepo = repository.NewInMemoryUserRepository()
+		revokedTokenRepo = repository.NewInMemoryRevokedTokenRepository()
+	}
+
+	todoRepo, closeTodoRepo, err := repository.NewTodoRepository(context.Background(), databaseURL, pgPool)
+	if err != nil {
+		log.Fatalf("Failed to initialize todo repository: %v", err)
+	}
+	defer closeTodoRepo()
+
+	todoService := service.NewTodoService(todoRepo)
+	authService := service.NewAuthService(userRepo, revokedTokenRepo, jwtSecret())
+
+	todoHandler := handlers.NewTodoHandler(todoService)
+	authHandler := handlers.NewAuthHandler(authService)
+	healthHandler := handlers.NewHealthHandler(ready)
+
+	srv := &http.Server{
+		Addr:    ":8080",
+		Handler: newRouter(logger, authService, authHandler, todoHandler, healthHandler),
+	}
+
+	go func() {
+		logger.Info("server starting", "addr", srv.Addr, "backend", backend)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("server error", "error", err)
+			os.Exit(1)
+		}
+	}()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	<-stop
+
+	logger.Info("shutting down")
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(ctx); err != nil {
+		logger.Error("graceful shutdown failed", "error", err)
+	}
+}
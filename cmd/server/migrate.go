@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/DreadHalfling9/REST-API-poc/internal/migrations"
+)
+
+// runMigrate handles `./server migrate <up|down|status>`. Migrations only
+// apply to Postgres; it connects directly rather than going through
+// repository.Backend since there's nothing sensible to migrate otherwise.
+func runMigrate(args []string) {
+	if len(args) == 0 {
+		log.Fatal("usage: server migrate <up|down|status>")
+	}
+
+	databaseURL := os.Getenv("DATABASE_URL")
+	if databaseURL == "" {
+		log.Fatal("DATABASE_URL not set")
+	}
+
+	db := connectPostgres(databaseURL)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		if err := migrations.Up(ctx, db); err != nil {
+			log.Fatalf("migrate up: %v", err)
+		}
+		fmt.Println("migrations applied")
+	case "down":
+		if err := migrations.Down(ctx, db, 1); err != nil {
+			log.Fatalf("migrate down: %v", err)
+		}
+		fmt.Println("last migration reverted")
+	case "status":
+		entries, err := migrations.Status(ctx, db)
+		if err != nil {
+			log.Fatalf("migrate status: %v", err)
+		}
+		for _, e := range entries {
+			state := "pending"
+			if e.Applied {
+				state = "applied"
+			}
+			fmt.Printf("%04d_%s: %s\n", e.Migration.Version, e.Migration.Name, state)
+		}
+	default:
+		log.Fatalf("unknown migrate subcommand %q", args[0])
+	}
+}
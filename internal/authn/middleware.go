@@ -0,0 +1,60 @@
+// Package authn provides the HTTP middleware that authenticates requests
+// via a Bearer JWT and threads the resulting user ID through the request
+// context.
+package authn
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/DreadHalfling9/REST-API-poc/internal/service"
+)
+
+type contextKey int
+
+const userIDKey contextKey = iota
+
+// Middleware extracts and validates the Authorization: Bearer JWT on every
+// request, rejecting the request with 401 if it's missing or invalid.
+func Middleware(auth *service.AuthService) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, err := bearerToken(r)
+			if err != nil {
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			userID, err := auth.Authenticate(r.Context(), token)
+			if err != nil {
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), userID)))
+		})
+	}
+}
+
+// NewContext returns a copy of ctx carrying the authenticated user ID, as
+// Middleware does for every request it admits.
+func NewContext(ctx context.Context, userID int) context.Context {
+	return context.WithValue(ctx, userIDKey, userID)
+}
+
+func bearerToken(r *http.Request) (string, error) {
+	header := r.Header.Get("Authorization")
+	prefix := "Bearer "
+	if !strings.HasPrefix(header, prefix) {
+		return "", errors.New("missing bearer token")
+	}
+	return strings.TrimPrefix(header, prefix), nil
+}
+
+// UserID returns the authenticated user ID stored in ctx by Middleware.
+func UserID(ctx context.Context) (int, bool) {
+	id, ok := ctx.Value(userIDKey).(int)
+	return id, ok
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+const readyTimeout = 2 * time.Second
+
+// HealthHandler exposes liveness and readiness probes. ready is called by
+// Readyz to check whether the backing store is reachable; it should respect
+// ctx's deadline.
+type HealthHandler struct {
+	ready func(ctx context.Context) error
+}
+
+// NewHealthHandler builds a HealthHandler that uses ready to check whether
+// the service can currently serve traffic.
+func NewHealthHandler(ready func(ctx context.Context) error) *HealthHandler {
+	return &HealthHandler{ready: ready}
+}
+
+// Healthz reports whether the process is alive.
+func (h *HealthHandler) Healthz(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+// Readyz reports whether the service can currently serve traffic.
+func (h *HealthHandler) Readyz(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), readyTimeout)
+	defer cancel()
+
+	if err := h.ready(ctx); err != nil {
+		http.Error(w, "Not ready", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
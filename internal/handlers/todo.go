@@ -0,0 +1,333 @@
+// Package handlers wires HTTP requests to the service layer.
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/DreadHalfling9/REST-API-poc/internal/authn"
+	"github.com/DreadHalfling9/REST-API-poc/internal/models"
+	"github.com/DreadHalfling9/REST-API-poc/internal/repository"
+	"github.com/DreadHalfling9/REST-API-poc/internal/service"
+)
+
+const (
+	defaultTodosLimit = 20
+	maxTodosLimit     = 100
+)
+
+// TodoHandler exposes the todo service over HTTP.
+type TodoHandler struct {
+	service *service.TodoService
+}
+
+// NewTodoHandler builds a TodoHandler over the given service.
+func NewTodoHandler(service *service.TodoService) *TodoHandler {
+	return &TodoHandler{service: service}
+}
+
+// Routes mounts the todo endpoints onto r. Callers must apply authn.Middleware
+// upstream so the authenticated user ID is present in the request context.
+func (h *TodoHandler) Routes(r chi.Router) {
+	r.Get("/", h.List)
+	r.Post("/", h.Create)
+	r.Get("/{id}", h.Get)
+	r.Put("/{id}", h.Update)
+	r.Patch("/{id}", h.Patch)
+	r.Delete("/{id}", h.Delete)
+	r.Patch("/{id}/done", h.MarkDone)
+}
+
+func (h *TodoHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authn.UserID(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	q := r.URL.Query()
+	filter := models.TodoFilter{UserID: userID, Limit: defaultTodosLimit, Sort: "id", Order: "asc"}
+
+	if raw := q.Get("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 1 {
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+			return
+		}
+		filter.Limit = n
+	}
+	if filter.Limit > maxTodosLimit {
+		filter.Limit = maxTodosLimit
+	}
+
+	if raw := q.Get("offset"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 0 {
+			http.Error(w, "Invalid offset", http.StatusBadRequest)
+			return
+		}
+		filter.Offset = n
+	}
+
+	if raw := q.Get("after"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			http.Error(w, "Invalid after", http.StatusBadRequest)
+			return
+		}
+		filter.After = &n
+	}
+
+	if raw := q.Get("done"); raw != "" {
+		done, err := strconv.ParseBool(raw)
+		if err != nil {
+			http.Error(w, "Invalid done", http.StatusBadRequest)
+			return
+		}
+		filter.Done = &done
+	}
+
+	filter.Query = q.Get("q")
+
+	if raw := q.Get("sort"); raw != "" {
+		if raw != "id" && raw != "title" {
+			http.Error(w, "Invalid sort column", http.StatusBadRequest)
+			return
+		}
+		filter.Sort = raw
+	}
+
+	if raw := q.Get("order"); raw != "" {
+		lower := strings.ToLower(raw)
+		if lower != "asc" && lower != "desc" {
+			http.Error(w, "Invalid order", http.StatusBadRequest)
+			return
+		}
+		filter.Order = lower
+	}
+
+	// The after cursor assumes ascending-id ordering; a non-default sort/order
+	// would silently skip, repeat, or misorder rows, so reject the combination
+	// instead of returning wrong results.
+	if filter.After != nil && (filter.Sort != "id" || filter.Order != "asc") {
+		http.Error(w, "after is only supported with the default sort=id&order=asc", http.StatusBadRequest)
+		return
+	}
+
+	page, err := h.service.List(r.Context(), filter)
+	if err != nil {
+		http.Error(w, "Failed to fetch todos", http.StatusInternalServerError)
+		return
+	}
+
+	json.NewEncoder(w).Encode(page)
+}
+
+func (h *TodoHandler) Get(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authn.UserID(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	id, err := idParam(r)
+	if err != nil {
+		http.Error(w, "Invalid ID", http.StatusBadRequest)
+		return
+	}
+
+	t, err := h.service.Get(r.Context(), id, userID)
+	if errors.Is(err, repository.ErrNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, "Failed to fetch todo", http.StatusInternalServerError)
+		return
+	}
+
+	json.NewEncoder(w).Encode(t)
+}
+
+func (h *TodoHandler) Create(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authn.UserID(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var t models.Todo
+	if err := json.NewDecoder(r.Body).Decode(&t); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+	t.UserID = userID
+
+	created, err := h.service.Create(r.Context(), t)
+	if err != nil {
+		http.Error(w, "Failed to insert", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(created)
+}
+
+func (h *TodoHandler) Update(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authn.UserID(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	id, err := idParam(r)
+	if err != nil {
+		http.Error(w, "Invalid ID", http.StatusBadRequest)
+		return
+	}
+
+	var t models.Todo
+	if err := json.NewDecoder(r.Body).Decode(&t); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	updated, err := h.service.Update(r.Context(), id, userID, t)
+	if errors.Is(err, repository.ErrNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, "Update failed", http.StatusInternalServerError)
+		return
+	}
+
+	json.NewEncoder(w).Encode(updated)
+}
+
+// patchableTodoFields allowlists the JSON keys accepted by a merge patch.
+var patchableTodoFields = map[string]bool{"title": true, "done": true}
+
+// Patch applies an RFC 7396 JSON Merge Patch, touching only the fields the
+// caller supplied.
+func (h *TodoHandler) Patch(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authn.UserID(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	id, err := idParam(r)
+	if err != nil {
+		http.Error(w, "Invalid ID", http.StatusBadRequest)
+		return
+	}
+
+	if ct := r.Header.Get("Content-Type"); ct != "application/merge-patch+json" {
+		http.Error(w, "Content-Type must be application/merge-patch+json", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&raw); err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	var patch models.TodoPatch
+	for field, value := range raw {
+		if !patchableTodoFields[field] {
+			http.Error(w, "Unknown field: "+field, http.StatusBadRequest)
+			return
+		}
+		if string(value) == "null" {
+			http.Error(w, field+" cannot be null", http.StatusBadRequest)
+			return
+		}
+
+		switch field {
+		case "title":
+			var title string
+			if err := json.Unmarshal(value, &title); err != nil {
+				http.Error(w, "Invalid title", http.StatusBadRequest)
+				return
+			}
+			patch.Title = &title
+		case "done":
+			var done bool
+			if err := json.Unmarshal(value, &done); err != nil {
+				http.Error(w, "Invalid done", http.StatusBadRequest)
+				return
+			}
+			patch.Done = &done
+		}
+	}
+
+	updated, err := h.service.Patch(r.Context(), id, userID, patch)
+	if errors.Is(err, repository.ErrNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, "Patch failed", http.StatusInternalServerError)
+		return
+	}
+
+	json.NewEncoder(w).Encode(updated)
+}
+
+func (h *TodoHandler) MarkDone(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authn.UserID(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	id, err := idParam(r)
+	if err != nil {
+		http.Error(w, "Invalid ID", http.StatusBadRequest)
+		return
+	}
+
+	t, err := h.service.MarkDone(r.Context(), id, userID)
+	if errors.Is(err, repository.ErrNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, "Failed to mark done", http.StatusInternalServerError)
+		return
+	}
+
+	json.NewEncoder(w).Encode(t)
+}
+
+func (h *TodoHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authn.UserID(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	id, err := idParam(r)
+	if err != nil {
+		http.Error(w, "Invalid ID", http.StatusBadRequest)
+		return
+	}
+
+	err = h.service.Delete(r.Context(), id, userID)
+	if errors.Is(err, repository.ErrNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, "Delete failed", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func idParam(r *http.Request) (int, error) {
+	return strconv.Atoi(chi.URLParam(r, "id"))
+}
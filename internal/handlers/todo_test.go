@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/DreadHalfling9/REST-API-poc/internal/authn"
+	"github.com/DreadHalfling9/REST-API-poc/internal/repository"
+	"github.com/DreadHalfling9/REST-API-poc/internal/service"
+)
+
+// newTestRouter builds a TodoHandler over an in-memory repository, so the
+// tests need no database; mountTodoRoutes mounts its routes.
+func newTestRouter() *TodoHandler {
+	repo := repository.NewInMemoryTodoRepository()
+	return NewTodoHandler(service.NewTodoService(repo))
+}
+
+func newTestRequest(method, target string, body string, userID int) (*http.Request, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req = req.WithContext(authn.NewContext(req.Context(), userID))
+	return req, httptest.NewRecorder()
+}
+
+func mountTodoRoutes(h *TodoHandler) *chi.Mux {
+	r := chi.NewRouter()
+	r.Route("/todos", h.Routes)
+	return r
+}
+
+func TestTodoHandlerCreateAndGet(t *testing.T) {
+	h := newTestRouter()
+	router := mountTodoRoutes(h)
+
+	req, rec := newTestRequest(http.MethodPost, "/todos/", `{"title":"write tests"}`, 1)
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("Create: status = %d, want %d; body = %s", rec.Code, http.StatusCreated, rec.Body)
+	}
+
+	req, rec = newTestRequest(http.MethodGet, "/todos/1", "", 1)
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Get: status = %d, want %d; body = %s", rec.Code, http.StatusOK, rec.Body)
+	}
+}
+
+func TestTodoHandlerGetOtherUsersTodoNotFound(t *testing.T) {
+	h := newTestRouter()
+	router := mountTodoRoutes(h)
+
+	req, rec := newTestRequest(http.MethodPost, "/todos/", `{"title":"mine"}`, 1)
+	router.ServeHTTP(rec, req)
+
+	req, rec = newTestRequest(http.MethodGet, "/todos/1", "", 2)
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("Get as other user: status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestTodoHandlerPatchRequiresMergePatchContentType(t *testing.T) {
+	h := newTestRouter()
+	router := mountTodoRoutes(h)
+
+	req, rec := newTestRequest(http.MethodPost, "/todos/", `{"title":"patch me"}`, 1)
+	router.ServeHTTP(rec, req)
+
+	req, rec = newTestRequest(http.MethodPatch, "/todos/1", `{"done":true}`, 1)
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnsupportedMediaType {
+		t.Fatalf("Patch with wrong Content-Type: status = %d, want %d", rec.Code, http.StatusUnsupportedMediaType)
+	}
+
+	req, rec = newTestRequest(http.MethodPatch, "/todos/1", `{"done":true}`, 1)
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Patch with merge-patch Content-Type: status = %d, want %d; body = %s", rec.Code, http.StatusOK, rec.Body)
+	}
+}
+
+func TestTodoHandlerPatchRejectsNullField(t *testing.T) {
+	h := newTestRouter()
+	router := mountTodoRoutes(h)
+
+	req, rec := newTestRequest(http.MethodPost, "/todos/", `{"title":"patch me"}`, 1)
+	router.ServeHTTP(rec, req)
+
+	req, rec = newTestRequest(http.MethodPatch, "/todos/1", `{"title":null}`, 1)
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("Patch with null title: status = %d, want %d; body = %s", rec.Code, http.StatusBadRequest, rec.Body)
+	}
+}
+
+func TestTodoHandlerListRejectsAfterWithNonDefaultSort(t *testing.T) {
+	h := newTestRouter()
+	router := mountTodoRoutes(h)
+
+	req, rec := newTestRequest(http.MethodGet, "/todos/?sort=title&order=desc&after=1", "", 1)
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("List with after+non-default sort: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,103 @@
+// Package migrations embeds the project's versioned SQL files and applies
+// them against Postgres, tracking progress in a schema_migrations table.
+package migrations
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed sql/*.sql
+var files embed.FS
+
+// Migration is a single versioned schema change, with the SQL to apply it
+// (Up) and to revert it (Down).
+type Migration struct {
+	Version  int
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+}
+
+// Load reads and parses the embedded SQL files, returning migrations sorted
+// by version.
+func Load() ([]Migration, error) {
+	entries, err := fs.ReadDir(files, "sql")
+	if err != nil {
+		return nil, fmt.Errorf("read migrations dir: %w", err)
+	}
+
+	byVersion := map[int]*Migration{}
+	for _, entry := range entries {
+		name := entry.Name()
+		version, label, direction, ok := parseFilename(name)
+		if !ok {
+			continue
+		}
+
+		content, err := fs.ReadFile(files, "sql/"+name)
+		if err != nil {
+			return nil, fmt.Errorf("read migration %s: %w", name, err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: label}
+			byVersion[version] = m
+		}
+
+		switch direction {
+		case "up":
+			m.Up = string(content)
+			m.Checksum = checksum(content)
+		case "down":
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+// parseFilename splits "0001_create_todos.up.sql" into version 1,
+// name "create_todos", direction "up".
+func parseFilename(name string) (version int, label, direction string, ok bool) {
+	trimmed := strings.TrimSuffix(name, ".sql")
+	direction = "up"
+	switch {
+	case strings.HasSuffix(trimmed, ".up"):
+		trimmed = strings.TrimSuffix(trimmed, ".up")
+	case strings.HasSuffix(trimmed, ".down"):
+		trimmed = strings.TrimSuffix(trimmed, ".down")
+		direction = "down"
+	default:
+		return 0, "", "", false
+	}
+
+	versionStr, label, found := strings.Cut(trimmed, "_")
+	if !found {
+		return 0, "", "", false
+	}
+	version, err := strconv.Atoi(versionStr)
+	if err != nil {
+		return 0, "", "", false
+	}
+	return version, label, direction, true
+}
+
+func checksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,176 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const schemaMigrationsTable = `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+    id         SERIAL PRIMARY KEY,
+    version    INTEGER NOT NULL UNIQUE,
+    checksum   TEXT NOT NULL,
+    applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+)`
+
+// StatusEntry describes whether a migration has been applied.
+type StatusEntry struct {
+	Migration Migration
+	Applied   bool
+}
+
+func ensureSchemaTable(ctx context.Context, pool *pgxpool.Pool) error {
+	if _, err := pool.Exec(ctx, schemaMigrationsTable); err != nil {
+		return fmt.Errorf("ensure schema_migrations table: %w", err)
+	}
+	return nil
+}
+
+func appliedChecksums(ctx context.Context, pool *pgxpool.Pool) (map[int]string, error) {
+	rows, err := pool.Query(ctx, "SELECT version, checksum FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("query applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := map[int]string{}
+	for rows.Next() {
+		var version int
+		var checksum string
+		if err := rows.Scan(&version, &checksum); err != nil {
+			return nil, fmt.Errorf("scan applied migration: %w", err)
+		}
+		applied[version] = checksum
+	}
+	return applied, nil
+}
+
+// Up applies every migration that hasn't been applied yet, in version order.
+func Up(ctx context.Context, pool *pgxpool.Pool) error {
+	if err := ensureSchemaTable(ctx, pool); err != nil {
+		return err
+	}
+
+	all, err := Load()
+	if err != nil {
+		return err
+	}
+
+	applied, err := appliedChecksums(ctx, pool)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range all {
+		if _, ok := applied[m.Version]; ok {
+			continue
+		}
+
+		tx, err := pool.Begin(ctx)
+		if err != nil {
+			return fmt.Errorf("begin migration %d: %w", m.Version, err)
+		}
+
+		if _, err := tx.Exec(ctx, m.Up); err != nil {
+			tx.Rollback(ctx)
+			return fmt.Errorf("apply migration %d (%s): %w", m.Version, m.Name, err)
+		}
+
+		if _, err := tx.Exec(ctx,
+			"INSERT INTO schema_migrations (version, checksum) VALUES ($1, $2)",
+			m.Version, m.Checksum); err != nil {
+			tx.Rollback(ctx)
+			return fmt.Errorf("record migration %d: %w", m.Version, err)
+		}
+
+		if err := tx.Commit(ctx); err != nil {
+			return fmt.Errorf("commit migration %d: %w", m.Version, err)
+		}
+	}
+
+	return nil
+}
+
+// Down reverts the `steps` most recently applied migrations, most recent
+// first.
+func Down(ctx context.Context, pool *pgxpool.Pool, steps int) error {
+	if err := ensureSchemaTable(ctx, pool); err != nil {
+		return err
+	}
+
+	all, err := Load()
+	if err != nil {
+		return err
+	}
+	byVersion := map[int]Migration{}
+	for _, m := range all {
+		byVersion[m.Version] = m
+	}
+
+	applied, err := appliedChecksums(ctx, pool)
+	if err != nil {
+		return err
+	}
+
+	versions := make([]int, 0, len(applied))
+	for v := range applied {
+		versions = append(versions, v)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(versions)))
+
+	for i := 0; i < steps && i < len(versions); i++ {
+		version := versions[i]
+		m, ok := byVersion[version]
+		if !ok {
+			return fmt.Errorf("no migration source found for applied version %d", version)
+		}
+
+		tx, err := pool.Begin(ctx)
+		if err != nil {
+			return fmt.Errorf("begin revert of migration %d: %w", version, err)
+		}
+
+		if _, err := tx.Exec(ctx, m.Down); err != nil {
+			tx.Rollback(ctx)
+			return fmt.Errorf("revert migration %d (%s): %w", version, m.Name, err)
+		}
+
+		if _, err := tx.Exec(ctx, "DELETE FROM schema_migrations WHERE version = $1", version); err != nil {
+			tx.Rollback(ctx)
+			return fmt.Errorf("unrecord migration %d: %w", version, err)
+		}
+
+		if err := tx.Commit(ctx); err != nil {
+			return fmt.Errorf("commit revert of migration %d: %w", version, err)
+		}
+	}
+
+	return nil
+}
+
+// Status reports, for every known migration, whether it has been applied.
+func Status(ctx context.Context, pool *pgxpool.Pool) ([]StatusEntry, error) {
+	if err := ensureSchemaTable(ctx, pool); err != nil {
+		return nil, err
+	}
+
+	all, err := Load()
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := appliedChecksums(ctx, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]StatusEntry, 0, len(all))
+	for _, m := range all {
+		_, ok := applied[m.Version]
+		entries = append(entries, StatusEntry{Migration: m, Applied: ok})
+	}
+	return entries, nil
+}
@@ -0,0 +1,47 @@
+// Package models holds the domain types shared across the service and
+// repository layers.
+package models
+
+import "time"
+
+// Todo is a single to-do item, owned by the user that created it.
+type Todo struct {
+	ID        int       `json:"id"`
+	Title     string    `json:"title"`
+	Done      bool      `json:"done"`
+	UserID    int       `json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TodoFilter captures the query parameters accepted by GET /todos, scoped
+// to the authenticated user.
+type TodoFilter struct {
+	UserID int
+	Limit  int
+	Offset int
+	After  *int
+	Done   *bool
+	Query  string
+	Sort   string
+	Order  string
+}
+
+// TodoPage is the paginated envelope returned by TodoRepository.List.
+type TodoPage struct {
+	Items      []Todo `json:"items"`
+	NextCursor *int   `json:"next_cursor"`
+	Total      int    `json:"total"`
+}
+
+// TodoPatch holds the subset of Todo fields a PATCH request supplied. A nil
+// field means the caller didn't include it and it must be left unchanged.
+type TodoPatch struct {
+	Title *string
+	Done  *bool
+}
+
+// IsEmpty reports whether the patch supplied no fields to change.
+func (p TodoPatch) IsEmpty() bool {
+	return p.Title == nil && p.Done == nil
+}
@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// User is an account that owns todos.
+type User struct {
+	ID           int       `json:"id"`
+	Email        string    `json:"email"`
+	PasswordHash string    `json:"-"`
+	CreatedAt    time.Time `json:"created_at"`
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Backend classifies DATABASE_URL by scheme ("postgres://", "sqlite://",
+// "memory://") into the storage backend the server should use. Callers use
+// this up front to decide whether a pgx pool (and Postgres-only features
+// like migrations) are needed at all.
+func Backend(databaseURL string) (string, error) {
+	u, err := url.Parse(databaseURL)
+	if err != nil {
+		return "", fmt.Errorf("parse DATABASE_URL: %w", err)
+	}
+
+	switch strings.TrimSuffix(u.Scheme, ":") {
+	case "postgres", "postgresql":
+		return "postgres", nil
+	case "sqlite":
+		return "sqlite", nil
+	case "memory":
+		return "memory", nil
+	default:
+		return "", fmt.Errorf("unsupported DATABASE_URL scheme %q", u.Scheme)
+	}
+}
+
+// NewTodoRepository selects a TodoRepository implementation based on the
+// scheme of databaseURL, as done in comparable Go REST projects. pgPool is
+// reused for the postgres case so callers that already hold a pool don't
+// open a second connection; it is only required when Backend(databaseURL)
+// is "postgres". The returned close func releases any resources the
+// repository itself opened and is always safe to call.
+func NewTodoRepository(ctx context.Context, databaseURL string, pgPool *pgxpool.Pool) (TodoRepository, func() error, error) {
+	backend, err := Backend(databaseURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	noop := func() error { return nil }
+
+	switch backend {
+	case "postgres":
+		if pgPool == nil {
+			return nil, nil, fmt.Errorf("postgres DATABASE_URL requires a connection pool")
+		}
+		return NewPostgresTodoRepository(pgPool), noop, nil
+
+	case "sqlite":
+		path := strings.TrimPrefix(databaseURL, "sqlite://")
+		db, err := sql.Open("sqlite3", path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("open sqlite database: %w", err)
+		}
+		if err := db.PingContext(ctx); err != nil {
+			return nil, nil, fmt.Errorf("ping sqlite database: %w", err)
+		}
+		repo, err := NewSQLiteTodoRepository(db)
+		if err != nil {
+			return nil, nil, err
+		}
+		return repo, db.Close, nil
+
+	default: // "memory"
+		return NewInMemoryTodoRepository(), noop, nil
+	}
+}
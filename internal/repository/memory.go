@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DreadHalfling9/REST-API-poc/internal/models"
+)
+
+// InMemoryTodoRepository is a TodoRepository backed by a map guarded by an
+// RWMutex. It keeps no state outside the process, which makes it ideal for
+// handler tests that shouldn't depend on a live database.
+type InMemoryTodoRepository struct {
+	mu     sync.RWMutex
+	todos  map[int]models.Todo
+	nextID int
+}
+
+var _ TodoRepository = (*InMemoryTodoRepository)(nil)
+
+// NewInMemoryTodoRepository builds an empty InMemoryTodoRepository.
+func NewInMemoryTodoRepository() *InMemoryTodoRepository {
+	return &InMemoryTodoRepository{todos: make(map[int]models.Todo), nextID: 1}
+}
+
+func (r *InMemoryTodoRepository) List(_ context.Context, filter models.TodoFilter) (models.TodoPage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matched []models.Todo
+	for _, t := range r.todos {
+		if t.UserID != filter.UserID {
+			continue
+		}
+		if filter.Done != nil && t.Done != *filter.Done {
+			continue
+		}
+		if filter.Query != "" && !strings.Contains(strings.ToLower(t.Title), strings.ToLower(filter.Query)) {
+			continue
+		}
+		if filter.After != nil && t.ID <= *filter.After {
+			continue
+		}
+		matched = append(matched, t)
+	}
+
+	sortCol := filter.Sort
+	if sortCol != "id" && sortCol != "title" {
+		sortCol = "id"
+	}
+	desc := strings.EqualFold(filter.Order, "desc")
+	sort.Slice(matched, func(i, j int) bool {
+		less := matched[i].ID < matched[j].ID
+		if sortCol == "title" && matched[i].Title != matched[j].Title {
+			less = matched[i].Title < matched[j].Title
+		}
+		if desc {
+			return !less
+		}
+		return less
+	})
+
+	total := len(matched)
+
+	if filter.After == nil && filter.Offset > 0 {
+		if filter.Offset >= len(matched) {
+			matched = nil
+		} else {
+			matched = matched[filter.Offset:]
+		}
+	}
+
+	limit := filter.Limit
+	if limit > 0 && len(matched) > limit {
+		matched = matched[:limit]
+	}
+
+	page := models.TodoPage{Items: matched, Total: total}
+	if len(matched) == limit && limit > 0 {
+		next := matched[len(matched)-1].ID
+		page.NextCursor = &next
+	}
+	return page, nil
+}
+
+func (r *InMemoryTodoRepository) Get(_ context.Context, id, userID int) (models.Todo, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	t, ok := r.todos[id]
+	if !ok || t.UserID != userID {
+		return models.Todo{}, ErrNotFound
+	}
+	return t, nil
+}
+
+func (r *InMemoryTodoRepository) Create(_ context.Context, t models.Todo) (models.Todo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	t.ID = r.nextID
+	t.CreatedAt = now
+	t.UpdatedAt = now
+	r.nextID++
+	r.todos[t.ID] = t
+	return t, nil
+}
+
+func (r *InMemoryTodoRepository) Update(_ context.Context, id, userID int, t models.Todo) (models.Todo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.todos[id]
+	if !ok || existing.UserID != userID {
+		return models.Todo{}, ErrNotFound
+	}
+
+	t.ID = id
+	t.UserID = userID
+	t.CreatedAt = existing.CreatedAt
+	t.UpdatedAt = time.Now()
+	r.todos[id] = t
+	return t, nil
+}
+
+func (r *InMemoryTodoRepository) Patch(_ context.Context, id, userID int, patch models.TodoPatch) (models.Todo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.todos[id]
+	if !ok || existing.UserID != userID {
+		return models.Todo{}, ErrNotFound
+	}
+
+	if patch.Title != nil {
+		existing.Title = *patch.Title
+	}
+	if patch.Done != nil {
+		existing.Done = *patch.Done
+	}
+	existing.UpdatedAt = time.Now()
+	r.todos[id] = existing
+	return existing, nil
+}
+
+func (r *InMemoryTodoRepository) MarkDone(_ context.Context, id, userID int) (models.Todo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.todos[id]
+	if !ok || existing.UserID != userID {
+		return models.Todo{}, ErrNotFound
+	}
+
+	existing.Done = true
+	existing.UpdatedAt = time.Now()
+	r.todos[id] = existing
+	return existing, nil
+}
+
+func (r *InMemoryTodoRepository) Delete(_ context.Context, id, userID int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.todos[id]
+	if !ok || existing.UserID != userID {
+		return ErrNotFound
+	}
+	delete(r.todos, id)
+	return nil
+}
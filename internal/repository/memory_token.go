@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// InMemoryRevokedTokenRepository is a RevokedTokenRepository backed by a map
+// guarded by an RWMutex, used when the server is run against a non-Postgres
+// DATABASE_URL (sqlite:// or memory://).
+type InMemoryRevokedTokenRepository struct {
+	mu      sync.RWMutex
+	revoked map[string]time.Time
+}
+
+var _ RevokedTokenRepository = (*InMemoryRevokedTokenRepository)(nil)
+
+// NewInMemoryRevokedTokenRepository builds an empty InMemoryRevokedTokenRepository.
+func NewInMemoryRevokedTokenRepository() *InMemoryRevokedTokenRepository {
+	return &InMemoryRevokedTokenRepository{revoked: make(map[string]time.Time)}
+}
+
+func (r *InMemoryRevokedTokenRepository) Revoke(_ context.Context, jti string, expiresAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.revoked[jti] = expiresAt
+	return nil
+}
+
+func (r *InMemoryRevokedTokenRepository) IsRevoked(_ context.Context, jti string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.revoked[jti]
+	return ok, nil
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/DreadHalfling9/REST-API-poc/internal/models"
+)
+
+// InMemoryUserRepository is a UserRepository backed by a map guarded by an
+// RWMutex, used when the server is run against a non-Postgres DATABASE_URL
+// (sqlite:// or memory://), which has no table to store accounts in.
+type InMemoryUserRepository struct {
+	mu      sync.RWMutex
+	byID    map[int]models.User
+	byEmail map[string]int
+	nextID  int
+}
+
+var _ UserRepository = (*InMemoryUserRepository)(nil)
+
+// NewInMemoryUserRepository builds an empty InMemoryUserRepository.
+func NewInMemoryUserRepository() *InMemoryUserRepository {
+	return &InMemoryUserRepository{
+		byID:    make(map[int]models.User),
+		byEmail: make(map[string]int),
+		nextID:  1,
+	}
+}
+
+func (r *InMemoryUserRepository) Create(_ context.Context, email, passwordHash string) (models.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.byEmail[email]; exists {
+		return models.User{}, ErrEmailTaken
+	}
+
+	u := models.User{
+		ID:           r.nextID,
+		Email:        email,
+		PasswordHash: passwordHash,
+		CreatedAt:    time.Now(),
+	}
+	r.byID[u.ID] = u
+	r.byEmail[email] = u.ID
+	r.nextID++
+	return u, nil
+}
+
+func (r *InMemoryUserRepository) GetByEmail(_ context.Context, email string) (models.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	id, ok := r.byEmail[email]
+	if !ok {
+		return models.User{}, ErrUserNotFound
+	}
+	return r.byID[id], nil
+}
+
+func (r *InMemoryUserRepository) GetByID(_ context.Context, id int) (models.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	u, ok := r.byID[id]
+	if !ok {
+		return models.User{}, ErrUserNotFound
+	}
+	return u, nil
+}
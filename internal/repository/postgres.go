@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/DreadHalfling9/REST-API-poc/internal/models"
+)
+
+// sortableColumns allowlists the columns that may be used in ORDER BY to
+// prevent SQL injection via the filter's Sort field.
+var sortableColumns = map[string]string{
+	"id":    "id",
+	"title": "title",
+}
+
+// PostgresTodoRepository is a TodoRepository backed by Postgres via pgx.
+type PostgresTodoRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ TodoRepository = (*PostgresTodoRepository)(nil)
+
+// NewPostgresTodoRepository builds a PostgresTodoRepository over an
+// existing connection pool.
+func NewPostgresTodoRepository(db *pgxpool.Pool) *PostgresTodoRepository {
+	return &PostgresTodoRepository{db: db}
+}
+
+func (r *PostgresTodoRepository) List(ctx context.Context, filter models.TodoFilter) (models.TodoPage, error) {
+	sortCol, ok := sortableColumns[filter.Sort]
+	if !ok {
+		sortCol = "id"
+	}
+	order := "ASC"
+	if strings.EqualFold(filter.Order, "desc") {
+		order = "DESC"
+	}
+
+	args := []any{filter.UserID}
+	where := []string{"user_id = $1"}
+
+	if filter.Done != nil {
+		args = append(args, *filter.Done)
+		where = append(where, fmt.Sprintf("done = $%d", len(args)))
+	}
+	if filter.Query != "" {
+		args = append(args, "%"+filter.Query+"%")
+		where = append(where, fmt.Sprintf("title ILIKE $%d", len(args)))
+	}
+	if filter.After != nil {
+		args = append(args, *filter.After)
+		where = append(where, fmt.Sprintf("id > $%d", len(args)))
+	}
+
+	whereClause := "WHERE " + strings.Join(where, " AND ")
+
+	var total int
+	countQuery := fmt.Sprintf("SELECT count(*) FROM todos %s", whereClause)
+	if err := r.db.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return models.TodoPage{}, fmt.Errorf("count todos: %w", err)
+	}
+
+	limit := filter.Limit
+	args = append(args, limit)
+	query := fmt.Sprintf("SELECT id, title, done, created_at, updated_at FROM todos %s ORDER BY %s %s LIMIT $%d",
+		whereClause, sortCol, order, len(args))
+
+	if filter.After == nil && filter.Offset > 0 {
+		args = append(args, filter.Offset)
+		query += fmt.Sprintf(" OFFSET $%d", len(args))
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return models.TodoPage{}, fmt.Errorf("list todos: %w", err)
+	}
+	defer rows.Close()
+
+	todos := []models.Todo{}
+	for rows.Next() {
+		var t models.Todo
+		if err := rows.Scan(&t.ID, &t.Title, &t.Done, &t.CreatedAt, &t.UpdatedAt); err != nil {
+			return models.TodoPage{}, fmt.Errorf("scan todo: %w", err)
+		}
+		t.UserID = filter.UserID
+		todos = append(todos, t)
+	}
+
+	page := models.TodoPage{Items: todos, Total: total}
+	if len(todos) == limit && limit > 0 {
+		next := todos[len(todos)-1].ID
+		page.NextCursor = &next
+	}
+	return page, nil
+}
+
+func (r *PostgresTodoRepository) Get(ctx context.Context, id, userID int) (models.Todo, error) {
+	var t models.Todo
+	err := r.db.QueryRow(ctx,
+		"SELECT id, title, done, created_at, updated_at FROM todos WHERE id = $1 AND user_id = $2", id, userID).
+		Scan(&t.ID, &t.Title, &t.Done, &t.CreatedAt, &t.UpdatedAt)
+	if err == pgx.ErrNoRows {
+		return models.Todo{}, ErrNotFound
+	}
+	if err != nil {
+		return models.Todo{}, fmt.Errorf("get todo: %w", err)
+	}
+	t.UserID = userID
+	return t, nil
+}
+
+func (r *PostgresTodoRepository) Create(ctx context.Context, t models.Todo) (models.Todo, error) {
+	err := r.db.QueryRow(ctx,
+		"INSERT INTO todos (title, done, user_id) VALUES ($1, $2, $3) RETURNING id, created_at, updated_at",
+		t.Title, t.Done, t.UserID).Scan(&t.ID, &t.CreatedAt, &t.UpdatedAt)
+	if err != nil {
+		return models.Todo{}, fmt.Errorf("create todo: %w", err)
+	}
+	return t, nil
+}
+
+func (r *PostgresTodoRepository) Update(ctx context.Context, id, userID int, t models.Todo) (models.Todo, error) {
+	tag, err := r.db.Exec(ctx,
+		"UPDATE todos SET title = $1, done = $2, updated_at = now() WHERE id = $3 AND user_id = $4",
+		t.Title, t.Done, id, userID)
+	if err != nil {
+		return models.Todo{}, fmt.Errorf("update todo: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return models.Todo{}, ErrNotFound
+	}
+	return r.Get(ctx, id, userID)
+}
+
+func (r *PostgresTodoRepository) Patch(ctx context.Context, id, userID int, patch models.TodoPatch) (models.Todo, error) {
+	if patch.IsEmpty() {
+		return r.Get(ctx, id, userID)
+	}
+
+	var set []string
+	var args []any
+
+	if patch.Title != nil {
+		args = append(args, *patch.Title)
+		set = append(set, fmt.Sprintf("title = $%d", len(args)))
+	}
+	if patch.Done != nil {
+		args = append(args, *patch.Done)
+		set = append(set, fmt.Sprintf("done = $%d", len(args)))
+	}
+
+	set = append(set, "updated_at = now()")
+
+	args = append(args, id, userID)
+	query := fmt.Sprintf("UPDATE todos SET %s WHERE id = $%d AND user_id = $%d",
+		strings.Join(set, ", "), len(args)-1, len(args))
+
+	tag, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return models.Todo{}, fmt.Errorf("patch todo: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return models.Todo{}, ErrNotFound
+	}
+
+	return r.Get(ctx, id, userID)
+}
+
+func (r *PostgresTodoRepository) MarkDone(ctx context.Context, id, userID int) (models.Todo, error) {
+	tag, err := r.db.Exec(ctx,
+		"UPDATE todos SET done = true, updated_at = now() WHERE id = $1 AND user_id = $2", id, userID)
+	if err != nil {
+		return models.Todo{}, fmt.Errorf("mark todo done: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return models.Todo{}, ErrNotFound
+	}
+	return r.Get(ctx, id, userID)
+}
+
+func (r *PostgresTodoRepository) Delete(ctx context.Context, id, userID int) error {
+	tag, err := r.db.Exec(ctx, "DELETE FROM todos WHERE id = $1 AND user_id = $2", id, userID)
+	if err != nil {
+		return fmt.Errorf("delete todo: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
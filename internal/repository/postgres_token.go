@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresRevokedTokenRepository is a RevokedTokenRepository backed by
+// Postgres via pgx.
+type PostgresRevokedTokenRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ RevokedTokenRepository = (*PostgresRevokedTokenRepository)(nil)
+
+// NewPostgresRevokedTokenRepository builds a PostgresRevokedTokenRepository
+// over an existing connection pool.
+func NewPostgresRevokedTokenRepository(db *pgxpool.Pool) *PostgresRevokedTokenRepository {
+	return &PostgresRevokedTokenRepository{db: db}
+}
+
+func (r *PostgresRevokedTokenRepository) Revoke(ctx context.Context, jti string, expiresAt time.Time) error {
+	_, err := r.db.Exec(ctx,
+		"INSERT INTO revoked_tokens (jti, expires_at) VALUES ($1, $2) ON CONFLICT (jti) DO NOTHING",
+		jti, expiresAt)
+	if err != nil {
+		return fmt.Errorf("revoke token: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresRevokedTokenRepository) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx,
+		"SELECT EXISTS(SELECT 1 FROM revoked_tokens WHERE jti = $1)", jti).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("check revoked token: %w", err)
+	}
+	return exists, nil
+}
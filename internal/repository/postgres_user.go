@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/DreadHalfling9/REST-API-poc/internal/models"
+)
+
+const pgUniqueViolation = "23505"
+
+// PostgresUserRepository is a UserRepository backed by Postgres via pgx.
+type PostgresUserRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ UserRepository = (*PostgresUserRepository)(nil)
+
+// NewPostgresUserRepository builds a PostgresUserRepository over an
+// existing connection pool.
+func NewPostgresUserRepository(db *pgxpool.Pool) *PostgresUserRepository {
+	return &PostgresUserRepository{db: db}
+}
+
+func (r *PostgresUserRepository) Create(ctx context.Context, email, passwordHash string) (models.User, error) {
+	var u models.User
+	err := r.db.QueryRow(ctx,
+		"INSERT INTO users (email, password_hash) VALUES ($1, $2) RETURNING id, email, password_hash, created_at",
+		email, passwordHash).Scan(&u.ID, &u.Email, &u.PasswordHash, &u.CreatedAt)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+		return models.User{}, ErrEmailTaken
+	}
+	if err != nil {
+		return models.User{}, fmt.Errorf("create user: %w", err)
+	}
+	return u, nil
+}
+
+func (r *PostgresUserRepository) GetByEmail(ctx context.Context, email string) (models.User, error) {
+	var u models.User
+	err := r.db.QueryRow(ctx,
+		"SELECT id, email, password_hash, created_at FROM users WHERE email = $1", email).
+		Scan(&u.ID, &u.Email, &u.PasswordHash, &u.CreatedAt)
+	if err == pgx.ErrNoRows {
+		return models.User{}, ErrUserNotFound
+	}
+	if err != nil {
+		return models.User{}, fmt.Errorf("get user by email: %w", err)
+	}
+	return u, nil
+}
+
+func (r *PostgresUserRepository) GetByID(ctx context.Context, id int) (models.User, error) {
+	var u models.User
+	err := r.db.QueryRow(ctx,
+		"SELECT id, email, password_hash, created_at FROM users WHERE id = $1", id).
+		Scan(&u.ID, &u.Email, &u.PasswordHash, &u.CreatedAt)
+	if err == pgx.ErrNoRows {
+		return models.User{}, ErrUserNotFound
+	}
+	if err != nil {
+		return models.User{}, fmt.Errorf("get user by id: %w", err)
+	}
+	return u, nil
+}
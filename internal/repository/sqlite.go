@@ -0,0 +1,203 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/DreadHalfling9/REST-API-poc/internal/models"
+)
+
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS todos (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    title      TEXT NOT NULL,
+    done       BOOLEAN NOT NULL DEFAULT 0,
+    user_id    INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+)`
+
+// SQLiteTodoRepository is a TodoRepository backed by a single SQLite file,
+// suited to lightweight single-process deployments.
+type SQLiteTodoRepository struct {
+	db *sql.DB
+}
+
+var _ TodoRepository = (*SQLiteTodoRepository)(nil)
+
+// NewSQLiteTodoRepository builds a SQLiteTodoRepository over db, creating
+// the todos table if it doesn't already exist.
+func NewSQLiteTodoRepository(db *sql.DB) (*SQLiteTodoRepository, error) {
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		return nil, fmt.Errorf("create todos table: %w", err)
+	}
+	return &SQLiteTodoRepository{db: db}, nil
+}
+
+func (r *SQLiteTodoRepository) List(ctx context.Context, filter models.TodoFilter) (models.TodoPage, error) {
+	sortCol, ok := sortableColumns[filter.Sort]
+	if !ok {
+		sortCol = "id"
+	}
+	order := "ASC"
+	if strings.EqualFold(filter.Order, "desc") {
+		order = "DESC"
+	}
+
+	args := []any{filter.UserID}
+	where := []string{"user_id = ?"}
+
+	if filter.Done != nil {
+		args = append(args, *filter.Done)
+		where = append(where, "done = ?")
+	}
+	if filter.Query != "" {
+		args = append(args, "%"+filter.Query+"%")
+		where = append(where, "title LIKE ?")
+	}
+	if filter.After != nil {
+		args = append(args, *filter.After)
+		where = append(where, "id > ?")
+	}
+
+	whereClause := "WHERE " + strings.Join(where, " AND ")
+
+	var total int
+	countQuery := fmt.Sprintf("SELECT count(*) FROM todos %s", whereClause)
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return models.TodoPage{}, fmt.Errorf("count todos: %w", err)
+	}
+
+	limit := filter.Limit
+	query := fmt.Sprintf("SELECT id, title, done, created_at, updated_at FROM todos %s ORDER BY %s %s LIMIT ?",
+		whereClause, sortCol, order)
+	args = append(args, limit)
+
+	if filter.After == nil && filter.Offset > 0 {
+		query += " OFFSET ?"
+		args = append(args, filter.Offset)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return models.TodoPage{}, fmt.Errorf("list todos: %w", err)
+	}
+	defer rows.Close()
+
+	todos := []models.Todo{}
+	for rows.Next() {
+		var t models.Todo
+		if err := rows.Scan(&t.ID, &t.Title, &t.Done, &t.CreatedAt, &t.UpdatedAt); err != nil {
+			return models.TodoPage{}, fmt.Errorf("scan todo: %w", err)
+		}
+		t.UserID = filter.UserID
+		todos = append(todos, t)
+	}
+
+	page := models.TodoPage{Items: todos, Total: total}
+	if len(todos) == limit && limit > 0 {
+		next := todos[len(todos)-1].ID
+		page.NextCursor = &next
+	}
+	return page, nil
+}
+
+func (r *SQLiteTodoRepository) Get(ctx context.Context, id, userID int) (models.Todo, error) {
+	var t models.Todo
+	err := r.db.QueryRowContext(ctx,
+		"SELECT id, title, done, created_at, updated_at FROM todos WHERE id = ? AND user_id = ?", id, userID).
+		Scan(&t.ID, &t.Title, &t.Done, &t.CreatedAt, &t.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return models.Todo{}, ErrNotFound
+	}
+	if err != nil {
+		return models.Todo{}, fmt.Errorf("get todo: %w", err)
+	}
+	t.UserID = userID
+	return t, nil
+}
+
+func (r *SQLiteTodoRepository) Create(ctx context.Context, t models.Todo) (models.Todo, error) {
+	res, err := r.db.ExecContext(ctx,
+		"INSERT INTO todos (title, done, user_id) VALUES (?, ?, ?)", t.Title, t.Done, t.UserID)
+	if err != nil {
+		return models.Todo{}, fmt.Errorf("create todo: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return models.Todo{}, fmt.Errorf("create todo: %w", err)
+	}
+	return r.Get(ctx, int(id), t.UserID)
+}
+
+func (r *SQLiteTodoRepository) Update(ctx context.Context, id, userID int, t models.Todo) (models.Todo, error) {
+	res, err := r.db.ExecContext(ctx,
+		"UPDATE todos SET title = ?, done = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ? AND user_id = ?",
+		t.Title, t.Done, id, userID)
+	if err != nil {
+		return models.Todo{}, fmt.Errorf("update todo: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return models.Todo{}, ErrNotFound
+	}
+	return r.Get(ctx, id, userID)
+}
+
+func (r *SQLiteTodoRepository) Patch(ctx context.Context, id, userID int, patch models.TodoPatch) (models.Todo, error) {
+	if patch.IsEmpty() {
+		return r.Get(ctx, id, userID)
+	}
+
+	var set []string
+	var args []any
+
+	if patch.Title != nil {
+		set = append(set, "title = ?")
+		args = append(args, *patch.Title)
+	}
+	if patch.Done != nil {
+		set = append(set, "done = ?")
+		args = append(args, *patch.Done)
+	}
+
+	set = append(set, "updated_at = CURRENT_TIMESTAMP")
+
+	args = append(args, id, userID)
+	query := fmt.Sprintf("UPDATE todos SET %s WHERE id = ? AND user_id = ?", strings.Join(set, ", "))
+
+	res, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return models.Todo{}, fmt.Errorf("patch todo: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return models.Todo{}, ErrNotFound
+	}
+	return r.Get(ctx, id, userID)
+}
+
+func (r *SQLiteTodoRepository) MarkDone(ctx context.Context, id, userID int) (models.Todo, error) {
+	res, err := r.db.ExecContext(ctx,
+		"UPDATE todos SET done = 1, updated_at = CURRENT_TIMESTAMP WHERE id = ? AND user_id = ?", id, userID)
+	if err != nil {
+		return models.Todo{}, fmt.Errorf("mark todo done: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return models.Todo{}, ErrNotFound
+	}
+	return r.Get(ctx, id, userID)
+}
+
+func (r *SQLiteTodoRepository) Delete(ctx context.Context, id, userID int) error {
+	res, err := r.db.ExecContext(ctx, "DELETE FROM todos WHERE id = ? AND user_id = ?", id, userID)
+	if err != nil {
+		return fmt.Errorf("delete todo: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+// Package repository defines the persistence boundary for todos and a
+// Postgres-backed implementation of it.
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/DreadHalfling9/REST-API-poc/internal/models"
+)
+
+// ErrNotFound is returned by repository methods when the requested todo
+// does not exist.
+var ErrNotFound = errors.New("todo not found")
+
+// TodoRepository is the persistence boundary for todos. Handlers depend on
+// this interface rather than on a concrete database client, which keeps
+// them testable against fakes. Every method besides List and Create scopes
+// its lookup to a user ID so one user can never read or mutate another
+// user's todos; a todo owned by someone else is indistinguishable from a
+// todo that doesn't exist.
+type TodoRepository interface {
+	List(ctx context.Context, filter models.TodoFilter) (models.TodoPage, error)
+	Get(ctx context.Context, id, userID int) (models.Todo, error)
+	Create(ctx context.Context, t models.Todo) (models.Todo, error)
+	Update(ctx context.Context, id, userID int, t models.Todo) (models.Todo, error)
+	Patch(ctx context.Context, id, userID int, patch models.TodoPatch) (models.Todo, error)
+	MarkDone(ctx context.Context, id, userID int) (models.Todo, error)
+	Delete(ctx context.Context, id, userID int) error
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// RevokedTokenRepository tracks JWTs that have been revoked (e.g. via
+// logout) before their natural expiry, keyed by the token's jti claim.
+type RevokedTokenRepository interface {
+	Revoke(ctx context.Context, jti string, expiresAt time.Time) error
+	IsRevoked(ctx context.Context, jti string) (bool, error)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/DreadHalfling9/REST-API-poc/internal/models"
+)
+
+// ErrUserNotFound is returned when no user matches the given lookup.
+var ErrUserNotFound = errors.New("user not found")
+
+// ErrEmailTaken is returned by Create when the email is already registered.
+var ErrEmailTaken = errors.New("email already registered")
+
+// UserRepository is the persistence boundary for user accounts.
+type UserRepository interface {
+	Create(ctx context.Context, email, passwordHash string) (models.User, error)
+	GetByEmail(ctx context.Context, email string) (models.User, error)
+	GetByID(ctx context.Context, id int) (models.User, error)
+}
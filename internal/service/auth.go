@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/DreadHalfling9/REST-API-poc/internal/models"
+	"github.com/DreadHalfling9/REST-API-poc/internal/repository"
+)
+
+// ErrInvalidCredentials is returned by Login when the email/password pair
+// does not match an account.
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// tokenTTL is how long an issued JWT remains valid.
+const tokenTTL = 24 * time.Hour
+
+// dummyPasswordHash is compared against on a Login for an unknown email, so
+// that a nonexistent account takes about as long to reject as a wrong
+// password for a real one, instead of leaking which emails are registered
+// via response time.
+const dummyPasswordHash = "$2a$10$cVG.O.X0JoImYj/QrDW0Y.d4r5ZkOaqiuW.AvlK25RnKy5001eAdG"
+
+// Claims are the custom claims embedded in every JWT this service issues.
+type Claims struct {
+	UserID int `json:"user_id"`
+	jwt.RegisteredClaims
+}
+
+// AuthService handles signup, login, and logout.
+type AuthService struct {
+	users     repository.UserRepository
+	revoked   repository.RevokedTokenRepository
+	jwtSecret []byte
+}
+
+// NewAuthService builds an AuthService over the given repositories, signing
+// issued tokens with jwtSecret.
+func NewAuthService(users repository.UserRepository, revoked repository.RevokedTokenRepository, jwtSecret []byte) *AuthService {
+	return &AuthService{users: users, revoked: revoked, jwtSecret: jwtSecret}
+}
+
+// Signup creates a new account with a bcrypt-hashed password.
+func (s *AuthService) Signup(ctx context.Context, email, password string) (models.User, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return models.User{}, fmt.Errorf("hash password: %w", err)
+	}
+	return s.users.Create(ctx, email, string(hash))
+}
+
+// Login verifies the given credentials and, on success, issues a signed JWT.
+func (s *AuthService) Login(ctx context.Context, email, password string) (string, error) {
+	user, err := s.users.GetByEmail(ctx, email)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		bcrypt.CompareHashAndPassword([]byte(dummyPasswordHash), []byte(password))
+		return "", ErrInvalidCredentials
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return "", ErrInvalidCredentials
+	}
+
+	return s.issueToken(user.ID)
+}
+
+// Logout revokes the given token so it can no longer authenticate requests,
+// even though it has not yet expired.
+func (s *AuthService) Logout(ctx context.Context, tokenString string) error {
+	claims, err := s.parse(tokenString)
+	if err != nil {
+		return err
+	}
+	return s.revoked.Revoke(ctx, claims.ID, claims.ExpiresAt.Time)
+}
+
+// Authenticate validates tokenString and returns the authenticated user ID.
+func (s *AuthService) Authenticate(ctx context.Context, tokenString string) (int, error) {
+	claims, err := s.parse(tokenString)
+	if err != nil {
+		return 0, err
+	}
+
+	revoked, err := s.revoked.IsRevoked(ctx, claims.ID)
+	if err != nil {
+		return 0, err
+	}
+	if revoked {
+		return 0, ErrInvalidCredentials
+	}
+
+	return claims.UserID, nil
+}
+
+func (s *AuthService) issueToken(userID int) (string, error) {
+	now := time.Now()
+	claims := Claims{
+		UserID: userID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(tokenTTL)),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.jwtSecret)
+}
+
+func (s *AuthService) parse(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (any, error) {
+		return s.jwtSecret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidCredentials
+	}
+	return claims, nil
+}
@@ -0,0 +1,48 @@
+// Package service holds the business logic for todos, sitting between the
+// HTTP handlers and the repository layer.
+package service
+
+import (
+	"context"
+
+	"github.com/DreadHalfling9/REST-API-poc/internal/models"
+	"github.com/DreadHalfling9/REST-API-poc/internal/repository"
+)
+
+// TodoService implements the todo use cases on top of a TodoRepository.
+type TodoService struct {
+	repo repository.TodoRepository
+}
+
+// NewTodoService builds a TodoService over the given repository.
+func NewTodoService(repo repository.TodoRepository) *TodoService {
+	return &TodoService{repo: repo}
+}
+
+func (s *TodoService) List(ctx context.Context, filter models.TodoFilter) (models.TodoPage, error) {
+	return s.repo.List(ctx, filter)
+}
+
+func (s *TodoService) Get(ctx context.Context, id, userID int) (models.Todo, error) {
+	return s.repo.Get(ctx, id, userID)
+}
+
+func (s *TodoService) Create(ctx context.Context, t models.Todo) (models.Todo, error) {
+	return s.repo.Create(ctx, t)
+}
+
+func (s *TodoService) Update(ctx context.Context, id, userID int, t models.Todo) (models.Todo, error) {
+	return s.repo.Update(ctx, id, userID, t)
+}
+
+func (s *TodoService) Patch(ctx context.Context, id, userID int, patch models.TodoPatch) (models.Todo, error) {
+	return s.repo.Patch(ctx, id, userID, patch)
+}
+
+func (s *TodoService) MarkDone(ctx context.Context, id, userID int) (models.Todo, error) {
+	return s.repo.MarkDone(ctx, id, userID)
+}
+
+func (s *TodoService) Delete(ctx context.Context, id, userID int) error {
+	return s.repo.Delete(ctx, id, userID)
+}